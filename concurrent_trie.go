@@ -0,0 +1,232 @@
+package trie
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// ConcurrentPathTrie is a Trier implementation safe for concurrent use by
+// multiple goroutines. Put and Delete clone the path from the root to the
+// affected node, then atomically swap in the new root; since published
+// nodes are never mutated, Get, Walk, and WalkPath never block on a writer
+// and never observe a partially-written tree. Writers still serialize on
+// each other through mu, so this trades write throughput for readers that
+// never wait.
+type ConcurrentPathTrie[T any] struct {
+	mu   sync.Mutex // serializes writers; readers go through root and never block
+	root atomic.Pointer[PathTrie[T]]
+}
+
+var _ Trier[any] = (*ConcurrentPathTrie[any])(nil)
+
+// NewConcurrentPathTrie allocates and returns a new *ConcurrentPathTrie.
+func NewConcurrentPathTrie[T any]() *ConcurrentPathTrie[T] {
+	trie := &ConcurrentPathTrie[T]{}
+	trie.root.Store(NewPathTrie[T]())
+	return trie
+}
+
+// NewConcurrentPathTrieWithConfig allocates and returns a new
+// *ConcurrentPathTrie with the given *PathTrieConfig.
+func NewConcurrentPathTrieWithConfig[T any](config *PathTrieConfig) *ConcurrentPathTrie[T] {
+	trie := &ConcurrentPathTrie[T]{}
+	trie.root.Store(NewPathTrieWithConfig[T](config))
+	return trie
+}
+
+// Get returns the Value stored at the given key, or the zero Value if none
+// is set. Get never blocks on a concurrent Put or Delete.
+func (trie *ConcurrentPathTrie[T]) Get(key string) T {
+	return trie.root.Load().Get(key)
+}
+
+// Put inserts the Value at the given key, replacing any existing Value. It
+// returns true if the put adds a new Value, false if it replaces an
+// existing one.
+func (trie *ConcurrentPathTrie[T]) Put(key string, value T) bool {
+	trie.mu.Lock()
+	defer trie.mu.Unlock()
+	newRoot, isNew := clonePut(trie.root.Load(), key, value)
+	trie.root.Store(newRoot)
+	return isNew
+}
+
+// Delete removes the Value stored at the given key. It returns true if a
+// Value was removed.
+func (trie *ConcurrentPathTrie[T]) Delete(key string) bool {
+	trie.mu.Lock()
+	defer trie.mu.Unlock()
+	newRoot, deleted := cloneDelete(trie.root.Load(), key)
+	if !deleted {
+		return false
+	}
+	trie.root.Store(newRoot)
+	return true
+}
+
+// Walk iterates over each key/Value stored in the trie and calls the given
+// walker function with the key and Value, using a consistent snapshot of
+// the tree unaffected by any concurrent Put or Delete.
+func (trie *ConcurrentPathTrie[T]) Walk(walker WalkFunc[T]) error {
+	return trie.Snapshot().Walk(walker)
+}
+
+// WalkPath iterates over each key/Value in the path from the root to the
+// node at the given key, using a consistent snapshot of the tree unaffected
+// by any concurrent Put or Delete.
+func (trie *ConcurrentPathTrie[T]) WalkPath(key string, walker WalkFunc[T]) error {
+	return trie.Snapshot().WalkPath(key, walker)
+}
+
+// Snapshot returns the *PathTrie backing trie at the moment of the call.
+// Because Put and Delete never mutate a published node in place, the
+// returned tree is immutable and safe to Walk for as long as the caller
+// likes without holding any lock.
+func (trie *ConcurrentPathTrie[T]) Snapshot() *PathTrie[T] {
+	return trie.root.Load()
+}
+
+// clonePath returns a shallow copy of node: its own fields are copied, and
+// its Children map is copied (but not the child nodes themselves, which are
+// shared with node until replaced along the write path).
+//
+// clonePut and cloneDelete below can't simply call PathTrie's own Put and
+// Delete on a cloned root, because those mutate every node along the write
+// path in place; clonePath is what keeps each write from touching a node
+// still reachable from an older, published root.
+func (node *PathTrie[T]) clonePath() *PathTrie[T] {
+	clone := &PathTrie[T]{
+		segmenter:     node.segmenter,
+		routing:       node.routing,
+		Value:         node.Value,
+		paramChild:    node.paramChild,
+		paramName:     node.paramName,
+		catchAllChild: node.catchAllChild,
+		catchAllName:  node.catchAllName,
+	}
+	if node.Children != nil {
+		clone.Children = make(map[string]*PathTrie[T], len(node.Children))
+		for part, child := range node.Children {
+			clone.Children[part] = child
+		}
+	}
+	return clone
+}
+
+// clonePut returns a new tree with value inserted at key, sharing every
+// subtree not on the path to key with root, and reports whether the put
+// added a new Value.
+func clonePut[T any](root *PathTrie[T], key string, value T) (*PathTrie[T], bool) {
+	newRoot := root.clonePath()
+	node := newRoot
+	for part, i := root.segmenter(key, 0); part != ""; part, i = root.segmenter(key, i) {
+		if node.routing {
+			if kind, name := wildcardKind(part); kind != 0 {
+				node = node.cloneWildcardChild(kind, name, i)
+				continue
+			}
+		}
+		var next *PathTrie[T]
+		if child := node.Children[part]; child != nil {
+			next = child.clonePath()
+		} else {
+			next = node.newPathTrie()
+		}
+		if node.Children == nil {
+			node.Children = map[string]*PathTrie[T]{}
+		}
+		node.Children[part] = next
+		node = next
+	}
+	isNew := node.Value == nil
+	node.Value = &value
+	return newRoot, isNew
+}
+
+// cloneWildcardChild behaves like putWildcard, but clones the target's
+// existing :param/*catchAll child (if any) via clonePath instead of
+// mutating it in place, so clonePut never touches a node still reachable
+// from an older, published root.
+func (node *PathTrie[T]) cloneWildcardChild(kind byte, name string, nextIndex int) *PathTrie[T] {
+	switch kind {
+	case ':':
+		if node.catchAllChild != nil {
+			panic("trie: cannot register :" + name + " after *" + node.catchAllName)
+		}
+		if node.paramChild != nil && node.paramName != name {
+			panic("trie: conflicting :param names :" + node.paramName + " and :" + name)
+		}
+		next := node.newPathTrie()
+		if node.paramChild != nil {
+			next = node.paramChild.clonePath()
+		}
+		node.paramChild = next
+		node.paramName = name
+		return next
+	case '*':
+		if node.paramChild != nil {
+			panic("trie: cannot register *" + name + " after :" + node.paramName)
+		}
+		if nextIndex != -1 {
+			panic("trie: *" + name + " must be the last segment of a route")
+		}
+		if node.catchAllChild != nil && node.catchAllName != name {
+			panic("trie: conflicting *catchAll names *" + node.catchAllName + " and *" + name)
+		}
+		next := node.newPathTrie()
+		if node.catchAllChild != nil {
+			next = node.catchAllChild.clonePath()
+		}
+		node.catchAllChild = next
+		node.catchAllName = name
+		return next
+	default:
+		panic("trie: unreachable wildcard kind")
+	}
+}
+
+// cloneDelete returns a new tree with the Value at key removed, sharing
+// every subtree not on the path to key with root, and reports whether a
+// Value was removed. It returns root unchanged if key has no Value. Like
+// PathTrie.Delete, key is matched literally, so deleting a wildcard route
+// means passing its :param/*catchAll form.
+func cloneDelete[T any](root *PathTrie[T], key string) (*PathTrie[T], bool) {
+	nodes, parts, kinds, found := root.deletePath(key)
+	if !found || nodes[len(nodes)-1].Value == nil {
+		return root, false
+	}
+
+	var below *PathTrie[T]
+	for i := len(nodes) - 1; i >= 0; i-- {
+		clone := nodes[i].clonePath()
+		if i == len(nodes)-1 {
+			clone.Value = nil
+		} else {
+			switch kinds[i] {
+			case ':':
+				clone.paramChild = below
+				if below == nil {
+					clone.paramName = ""
+				}
+			case '*':
+				clone.catchAllChild = below
+				if below == nil {
+					clone.catchAllName = ""
+				}
+			default:
+				if below == nil {
+					delete(clone.Children, parts[i])
+				} else {
+					clone.Children[parts[i]] = below
+				}
+			}
+		}
+
+		if i > 0 && clone.Value == nil && len(clone.Children) == 0 && clone.paramChild == nil && clone.catchAllChild == nil {
+			below = nil
+		} else {
+			below = clone
+		}
+	}
+	return below, true
+}
@@ -0,0 +1,144 @@
+package trie
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestConcurrentPathTriePutRouting(t *testing.T) {
+	trie := NewConcurrentPathTrieWithConfig[int](&PathTrieConfig{Routing: true})
+	trie.Put("/a/:id", 1)
+
+	if got := trie.Get("/a/:id"); got != 0 {
+		t.Fatalf("Get(/a/:id) = %d, want 0; :id should be registered as a wildcard, not a literal static segment", got)
+	}
+	if got, _, ok := trie.Snapshot().Match("/a/123"); !ok || got != 1 {
+		t.Fatalf("Match(/a/123) = %d, %v, want 1, true", got, ok)
+	}
+}
+
+func TestConcurrentPathTrieDeleteRouting(t *testing.T) {
+	trie := NewConcurrentPathTrieWithConfig[int](&PathTrieConfig{Routing: true})
+	trie.Put("/users/:id", 1)
+
+	if !trie.Delete("/users/:id") {
+		t.Fatalf("Delete(/users/:id) returned false")
+	}
+	if _, _, ok := trie.Snapshot().Match("/users/42"); ok {
+		t.Fatalf("Match(/users/42) still matched after deleting the :id route")
+	}
+}
+
+// rwMutexPathTrie is a naive RWMutex-wrapped PathTrie, benchmarked below
+// against ConcurrentPathTrie's copy-on-write design to quantify the
+// tradeoff: readers here serialize behind writers, while a
+// ConcurrentPathTrie reader never blocks.
+type rwMutexPathTrie[T any] struct {
+	mu   sync.RWMutex
+	root *PathTrie[T]
+}
+
+func newRWMutexPathTrie[T any]() *rwMutexPathTrie[T] {
+	return &rwMutexPathTrie[T]{root: NewPathTrie[T]()}
+}
+
+func (t *rwMutexPathTrie[T]) Get(key string) T {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.root.Get(key)
+}
+
+func (t *rwMutexPathTrie[T]) Put(key string, value T) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.root.Put(key, value)
+}
+
+func benchKeys(n int) []string {
+	keys := make([]string, n)
+	for i := range keys {
+		keys[i] = "/bench/" + strconv.Itoa(i)
+	}
+	return keys
+}
+
+func BenchmarkRWMutexPathTrieReadHeavy(b *testing.B) {
+	trie := newRWMutexPathTrie[int]()
+	keys := benchKeys(1000)
+	for i, k := range keys {
+		trie.Put(k, i)
+	}
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			trie.Get(keys[i%len(keys)])
+			i++
+		}
+	})
+}
+
+func BenchmarkConcurrentPathTrieReadHeavy(b *testing.B) {
+	trie := NewConcurrentPathTrie[int]()
+	keys := benchKeys(1000)
+	for i, k := range keys {
+		trie.Put(k, i)
+	}
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			trie.Get(keys[i%len(keys)])
+			i++
+		}
+	})
+}
+
+// mixed workloads make every tenth operation on each goroutine a write (one
+// write per nine reads).
+func BenchmarkRWMutexPathTrieMixed(b *testing.B) {
+	trie := newRWMutexPathTrie[int]()
+	keys := benchKeys(1000)
+	for i, k := range keys {
+		trie.Put(k, i)
+	}
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			k := keys[i%len(keys)]
+			if i%10 == 0 {
+				trie.Put(k, i)
+			} else {
+				trie.Get(k)
+			}
+			i++
+		}
+	})
+}
+
+func BenchmarkConcurrentPathTrieMixed(b *testing.B) {
+	trie := NewConcurrentPathTrie[int]()
+	keys := benchKeys(1000)
+	for i, k := range keys {
+		trie.Put(k, i)
+	}
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			k := keys[i%len(keys)]
+			if i%10 == 0 {
+				trie.Put(k, i)
+			} else {
+				trie.Get(k)
+			}
+			i++
+		}
+	})
+}
@@ -0,0 +1,81 @@
+// Package format renders a trie.PathTrie as human- and machine-readable
+// graphs: an indented ASCII tree for terminal/log output, and a Graphviz DOT
+// graph for architecture and package visualizations.
+package format
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/Zxilly/trie"
+)
+
+const (
+	branch      = "├── "
+	lastBranch  = "└── "
+	between     = "│   "
+	afterLast   = "    "
+	rootSegment = "."
+)
+
+// ASCIIOptions customizes WriteASCII's output.
+type ASCIIOptions[T any] struct {
+	// CollapseChains merges runs of single-child, value-less nodes into one
+	// line labeled with their concatenated keys, mirroring how
+	// RadixPathTrie compresses such chains.
+	CollapseChains bool
+}
+
+// WriteASCII renders root as an indented ASCII tree with box-drawing
+// connectors, in the style of the Unix `tree` command. Each line is the key
+// segment stored at that node; nodes are visited in sorted key order so
+// output is reproducible.
+func WriteASCII[T any](w io.Writer, root *trie.PathTrie[T], opts *ASCIIOptions[T]) error {
+	if opts == nil {
+		opts = &ASCIIOptions[T]{}
+	}
+	if _, err := fmt.Fprintln(w, rootSegment); err != nil {
+		return err
+	}
+	return writeASCIIChildren(w, root, "", opts)
+}
+
+func writeASCIIChildren[T any](w io.Writer, node *trie.PathTrie[T], prefix string, opts *ASCIIOptions[T]) error {
+	parts := sortedParts(node)
+	for i, part := range parts {
+		child := node.Children[part]
+		label := part
+		last := i == len(parts)-1
+
+		if opts.CollapseChains {
+			for child.Value == nil && len(child.Children) == 1 {
+				nextPart := sortedParts(child)[0]
+				label += nextPart
+				child = child.Children[nextPart]
+			}
+		}
+
+		connector, nextPrefix := branch, prefix+between
+		if last {
+			connector, nextPrefix = lastBranch, prefix+afterLast
+		}
+
+		if _, err := fmt.Fprintln(w, prefix+connector+label); err != nil {
+			return err
+		}
+		if err := writeASCIIChildren(w, child, nextPrefix, opts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sortedParts[T any](node *trie.PathTrie[T]) []string {
+	parts := make([]string, 0, len(node.Children))
+	for part := range node.Children {
+		parts = append(parts, part)
+	}
+	sort.Strings(parts)
+	return parts
+}
@@ -0,0 +1,47 @@
+package format
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/Zxilly/trie"
+)
+
+func TestWriteASCII(t *testing.T) {
+	root := trie.NewPathTrie[int]()
+	root.Put("/a", 1)
+	root.Put("/b", 2)
+
+	var buf bytes.Buffer
+	if err := WriteASCII[int](&buf, root, nil); err != nil {
+		t.Fatalf("WriteASCII: %v", err)
+	}
+
+	want := ".\n├── /a\n└── /b\n"
+	if buf.String() != want {
+		t.Fatalf("WriteASCII = %q, want %q", buf.String(), want)
+	}
+}
+
+func TestWriteASCIICollapseChains(t *testing.T) {
+	root := trie.NewPathTrie[int]()
+	root.Put("/a/b/c", 1)
+
+	var collapsed bytes.Buffer
+	if err := WriteASCII[int](&collapsed, root, &ASCIIOptions[int]{CollapseChains: true}); err != nil {
+		t.Fatalf("WriteASCII: %v", err)
+	}
+	want := ".\n└── /a/b/c\n"
+	if collapsed.String() != want {
+		t.Fatalf("WriteASCII with CollapseChains = %q, want %q", collapsed.String(), want)
+	}
+
+	var uncollapsed bytes.Buffer
+	if err := WriteASCII[int](&uncollapsed, root, nil); err != nil {
+		t.Fatalf("WriteASCII: %v", err)
+	}
+	wantUncollapsed := ".\n└── /a\n    └── /b\n        └── /c\n"
+	if uncollapsed.String() != wantUncollapsed {
+		t.Fatalf("WriteASCII without CollapseChains = %q, want %q", uncollapsed.String(), wantUncollapsed)
+	}
+}
@@ -0,0 +1,95 @@
+package format
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/Zxilly/trie"
+)
+
+// DOTOptions customizes WriteDOT's output.
+type DOTOptions[T any] struct {
+	// Label returns the label for the node reached by key. If nil, key is
+	// used as-is.
+	Label func(key string, node *trie.PathTrie[T]) string
+
+	// EdgeStyle returns Graphviz edge attributes (e.g. "color=red") for the
+	// edge leading into node. If nil, edges are unstyled.
+	EdgeStyle func(node *trie.PathTrie[T]) string
+
+	// CollapseChains merges runs of single-child, value-less nodes into one
+	// node labeled with their concatenated keys, mirroring how
+	// RadixPathTrie compresses such chains.
+	CollapseChains bool
+}
+
+// WriteDOT renders root as a Graphviz DOT graph to w, one node per trie
+// node reachable through Children (or per collapsed chain, with
+// CollapseChains) and one edge per parent/child link. The :param and
+// *catchAll children of a routing-enabled trie are not rendered. Supply
+// Label and EdgeStyle in opts to customize rendering without having to walk
+// the trie yourself.
+// Children are visited in sorted key order, so repeated calls on the same
+// trie produce byte-identical output.
+func WriteDOT[T any](w io.Writer, root *trie.PathTrie[T], opts *DOTOptions[T]) error {
+	if opts == nil {
+		opts = &DOTOptions[T]{}
+	}
+
+	if _, err := fmt.Fprintln(w, "digraph trie {"); err != nil {
+		return err
+	}
+	id := 0
+	if err := writeDOTNode(w, root, "", "node0", &id, opts); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintln(w, "}")
+	return err
+}
+
+func writeDOTNode[T any](w io.Writer, node *trie.PathTrie[T], key, nodeID string, id *int, opts *DOTOptions[T]) error {
+	label := key
+	if opts.Label != nil {
+		label = opts.Label(key, node)
+	}
+	if _, err := fmt.Fprintf(w, "  %s [label=%q];\n", nodeID, label); err != nil {
+		return err
+	}
+
+	for _, part := range sortedParts(node) {
+		child := node.Children[part]
+		childKey := key + part
+
+		if opts.CollapseChains {
+			for child.Value == nil && len(child.Children) == 1 {
+				var nextPart string
+				var next *trie.PathTrie[T]
+				for p, c := range child.Children {
+					nextPart, next = p, c
+				}
+				childKey += nextPart
+				child = next
+			}
+		}
+
+		*id++
+		childID := fmt.Sprintf("node%d", *id)
+
+		style := ""
+		if opts.EdgeStyle != nil {
+			style = opts.EdgeStyle(child)
+		}
+		if style != "" {
+			if _, err := fmt.Fprintf(w, "  %s -> %s [%s];\n", nodeID, childID, style); err != nil {
+				return err
+			}
+		} else if _, err := fmt.Fprintf(w, "  %s -> %s;\n", nodeID, childID); err != nil {
+			return err
+		}
+
+		if err := writeDOTNode(w, child, childKey, childID, id, opts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
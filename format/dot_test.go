@@ -0,0 +1,75 @@
+package format
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/Zxilly/trie"
+)
+
+func TestWriteDOTIsDeterministic(t *testing.T) {
+	root := trie.NewPathTrie[int]()
+	root.Put("/a", 1)
+	root.Put("/b", 2)
+	root.Put("/c", 3)
+	root.Put("/d", 4)
+
+	var first bytes.Buffer
+	if err := WriteDOT[int](&first, root, nil); err != nil {
+		t.Fatalf("WriteDOT: %v", err)
+	}
+
+	for i := 0; i < 10; i++ {
+		var got bytes.Buffer
+		if err := WriteDOT[int](&got, root, nil); err != nil {
+			t.Fatalf("WriteDOT: %v", err)
+		}
+		if got.String() != first.String() {
+			t.Fatalf("WriteDOT output changed across calls on the same trie:\n--- run 0 ---\n%s\n--- run %d ---\n%s", first.String(), i+1, got.String())
+		}
+	}
+}
+
+func TestWriteDOTCustomLabelAndEdgeStyle(t *testing.T) {
+	root := trie.NewPathTrie[int]()
+	root.Put("/a", 1)
+
+	var buf bytes.Buffer
+	opts := &DOTOptions[int]{
+		Label:     func(key string, node *trie.PathTrie[int]) string { return "node:" + key },
+		EdgeStyle: func(node *trie.PathTrie[int]) string { return "color=red" },
+	}
+	if err := WriteDOT[int](&buf, root, opts); err != nil {
+		t.Fatalf("WriteDOT: %v", err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, `label="node:/a"`) {
+		t.Fatalf("WriteDOT output missing custom label, got:\n%s", out)
+	}
+	if !strings.Contains(out, "[color=red]") {
+		t.Fatalf("WriteDOT output missing custom edge style, got:\n%s", out)
+	}
+}
+
+func TestWriteDOTCollapseChains(t *testing.T) {
+	root := trie.NewPathTrie[int]()
+	root.Put("/a/b/c", 1)
+
+	var collapsed bytes.Buffer
+	if err := WriteDOT[int](&collapsed, root, &DOTOptions[int]{CollapseChains: true}); err != nil {
+		t.Fatalf("WriteDOT: %v", err)
+	}
+	if strings.Count(collapsed.String(), "->") != 1 {
+		t.Fatalf("WriteDOT with CollapseChains should collapse the single-child chain /a -> /b -> /c into one edge, got:\n%s", collapsed.String())
+	}
+
+	var uncollapsed bytes.Buffer
+	if err := WriteDOT[int](&uncollapsed, root, nil); err != nil {
+		t.Fatalf("WriteDOT: %v", err)
+	}
+	if strings.Count(uncollapsed.String(), "->") != 3 {
+		t.Fatalf("WriteDOT without CollapseChains should keep every edge in the chain, got:\n%s", uncollapsed.String())
+	}
+}
@@ -10,13 +10,29 @@ package trie
 // nodes. A classic trie might segment keys by rune (i.e. unicode points).
 type PathTrie[T any] struct {
 	segmenter StringSegmenter // key segmenter, must not cause heap allocs
+	routing   bool            // recognize :param/*catchAll segments, see PathTrieConfig.Routing
 	Value     *T
 	Children  map[string]*PathTrie[T]
+
+	// paramChild and catchAllChild hold the single :param and *catchAll
+	// children of this node, kept apart from Children so that static
+	// segments always take priority during Match, then :param, then
+	// *catchAll. Only populated when routing is enabled.
+	paramChild    *PathTrie[T]
+	paramName     string
+	catchAllChild *PathTrie[T]
+	catchAllName  string
 }
 
+var _ Trier[any] = (*PathTrie[any])(nil)
+
 // PathTrieConfig for building a path trie with different segmenter
 type PathTrieConfig struct {
 	Segmenter StringSegmenter
+
+	// Routing opts into recognizing ":param" and "*catchAll" segments in
+	// Put, making the trie usable as an HTTP router backend via Match.
+	Routing bool
 }
 
 // NewPathTrie allocates and returns a new *PathTrie.
@@ -29,12 +45,17 @@ func NewPathTrie[T any]() *PathTrie[T] {
 // NewPathTrieWithConfig allocates and returns a new *PathTrie with the given *PathTrieConfig
 func NewPathTrieWithConfig[T any](config *PathTrieConfig) *PathTrie[T] {
 	segmenter := PathSegmenter
+	var routing bool
 	if config != nil && config.Segmenter != nil {
 		segmenter = config.Segmenter
 	}
+	if config != nil {
+		routing = config.Routing
+	}
 
 	return &PathTrie[T]{
 		segmenter: segmenter,
+		routing:   routing,
 	}
 }
 
@@ -42,6 +63,7 @@ func NewPathTrieWithConfig[T any](config *PathTrieConfig) *PathTrie[T] {
 func (trie *PathTrie[T]) newPathTrie() *PathTrie[T] {
 	return &PathTrie[T]{
 		segmenter: trie.segmenter,
+		routing:   trie.routing,
 	}
 }
 
@@ -63,9 +85,15 @@ func (trie *PathTrie[T]) Get(key string) T {
 // if it replaces an existing Value.
 // Note that internal nodes have nil values so a stored nil Value will not
 // be distinguishable and will not be included in Walks.
-func (trie *PathTrie[T]) Put(key string, value T) {
+func (trie *PathTrie[T]) Put(key string, value T) bool {
 	node := trie
 	for part, i := trie.segmenter(key, 0); part != ""; part, i = trie.segmenter(key, i) {
+		if node.routing {
+			if kind, name := wildcardKind(part); kind != 0 {
+				node = node.putWildcard(kind, name, i)
+				continue
+			}
+		}
 		child := node.Children[part]
 		if child == nil {
 			if node.Children == nil {
@@ -76,8 +104,87 @@ func (trie *PathTrie[T]) Put(key string, value T) {
 		}
 		node = child
 	}
+	isNewValue := node.Value == nil
 	node.Value = &value
+	return isNewValue
+}
+
+// Delete removes the Value stored at the given key. It returns true if a
+// Value was removed, false if no Value was present. Any ancestor nodes left
+// with neither a Value nor any children (static or wildcard) are pruned.
+// key is matched literally, the same way Put registers it, so deleting a
+// routing-enabled trie's route means passing its :param/*catchAll form
+// (e.g. "/users/:id"), not a concrete path that would match through it.
+func (trie *PathTrie[T]) Delete(key string) bool {
+	nodes, parts, kinds, found := trie.deletePath(key)
+	if !found {
+		return false
+	}
+	target := nodes[len(nodes)-1]
+	if target.Value == nil {
+		return false
+	}
+	target.Value = nil
+
+	for i := len(parts) - 1; i >= 0; i-- {
+		parent, child := nodes[i], nodes[i+1]
+		if child.Value != nil || len(child.Children) != 0 || child.paramChild != nil || child.catchAllChild != nil {
+			break
+		}
+		switch kinds[i] {
+		case ':':
+			parent.paramChild = nil
+			parent.paramName = ""
+		case '*':
+			parent.catchAllChild = nil
+			parent.catchAllName = ""
+		default:
+			delete(parent.Children, parts[i])
+		}
+	}
+	return true
+}
 
+// deletePath walks trie along key the same way Delete does, without
+// mutating anything, and reports the node visited at each step (nodes has
+// one more entry than parts: trie itself, then the node reached after each
+// segment), the literal segment/wildcard-kind taken at each step, and
+// whether every step along key resolved to an existing node. kinds[i] is 0
+// for a step into a static child, ':' for the :param child, or '*' for the
+// *catchAll child. ConcurrentPathTrie's cloneDelete reuses this to find what
+// to clone without duplicating the traversal logic.
+func (trie *PathTrie[T]) deletePath(key string) (nodes []*PathTrie[T], parts []string, kinds []byte, found bool) {
+	node := trie
+	nodes = append(nodes, node)
+	for part, i := trie.segmenter(key, 0); part != ""; part, i = trie.segmenter(key, i) {
+		var child *PathTrie[T]
+		var kind byte
+		if node.routing {
+			if k, name := wildcardKind(part); k != 0 {
+				switch k {
+				case ':':
+					if node.paramChild != nil && node.paramName == name {
+						child, kind = node.paramChild, ':'
+					}
+				case '*':
+					if node.catchAllChild != nil && node.catchAllName == name {
+						child, kind = node.catchAllChild, '*'
+					}
+				}
+			}
+		}
+		if kind == 0 {
+			child = node.Children[part]
+		}
+		if child == nil {
+			return nil, nil, nil, false
+		}
+		parts = append(parts, part)
+		kinds = append(kinds, kind)
+		nodes = append(nodes, child)
+		node = child
+	}
+	return nodes, parts, kinds, true
 }
 
 // Walk iterates over each key/Value stored in the trie and calls the given
@@ -123,6 +230,9 @@ func (trie *PathTrie[T]) WalkPath(key string, walker WalkFunc[T]) error {
 func (trie *PathTrie[T]) walk(key string, walker WalkFunc[T]) error {
 	if trie.Value != nil {
 		if err := walker(key, *trie.Value); err != nil {
+			if err == SkipSubtree {
+				return nil
+			}
 			return err
 		}
 	}
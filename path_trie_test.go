@@ -0,0 +1,63 @@
+package trie
+
+import "testing"
+
+var _ Trier[int] = (*PathTrie[int])(nil)
+
+func TestPathTriePutReturnsWhetherValueIsNew(t *testing.T) {
+	trie := NewPathTrie[int]()
+	if isNew := trie.Put("/a", 1); !isNew {
+		t.Fatalf("Put(/a) on an empty trie returned isNew=false")
+	}
+	if isNew := trie.Put("/a", 2); isNew {
+		t.Fatalf("Put(/a) over an existing Value returned isNew=true")
+	}
+}
+
+func TestPathTrieDelete(t *testing.T) {
+	trie := NewPathTrie[int]()
+	trie.Put("/a/b", 1)
+	trie.Put("/a/c", 2)
+
+	if trie.Delete("/a/x") {
+		t.Fatalf("Delete(/a/x) on a missing key returned true")
+	}
+	if !trie.Delete("/a/b") {
+		t.Fatalf("Delete(/a/b) returned false")
+	}
+	if got := trie.Get("/a/b"); got != 0 {
+		t.Fatalf("Get(/a/b) after Delete = %d, want 0", got)
+	}
+	if got := trie.Get("/a/c"); got != 2 {
+		t.Fatalf("Delete(/a/b) removed an unrelated sibling, Get(/a/c) = %d, want 2", got)
+	}
+
+	trie.Delete("/a/c")
+	if _, ok := trie.Children["/a"]; ok {
+		t.Fatalf("Delete left an empty ancestor node in place instead of pruning it")
+	}
+}
+
+func TestPathTrieDeleteWildcardRoute(t *testing.T) {
+	trie := NewPathTrieWithConfig[int](&PathTrieConfig{Routing: true})
+	trie.Put("/users/:id", 1)
+	trie.Put("/files/*path", 2)
+	users := trie.Children["/users"]
+
+	if !trie.Delete("/users/:id") {
+		t.Fatalf("Delete(/users/:id) returned false")
+	}
+	if _, _, ok := trie.Match("/users/42"); ok {
+		t.Fatalf("Match(/users/42) still matched after deleting the :id route")
+	}
+	if users.paramChild != nil {
+		t.Fatalf("Delete(/users/:id) left paramChild in place")
+	}
+
+	if !trie.Delete("/files/*path") {
+		t.Fatalf("Delete(/files/*path) returned false")
+	}
+	if _, _, ok := trie.Match("/files/a/b"); ok {
+		t.Fatalf("Match(/files/a/b) still matched after deleting the *path route")
+	}
+}
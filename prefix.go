@@ -0,0 +1,107 @@
+package trie
+
+// LongestPrefix returns the Value stored at the deepest node along key
+// whose own key is a prefix of key, along with that node's key. Equivalent
+// to running WalkPath and keeping the last successful call, but without the
+// walker indirection. ok is false if no node along key has a Value.
+func (trie *PathTrie[T]) LongestPrefix(key string) (matchedKey string, value T, ok bool) {
+	node := trie
+	if node.Value != nil {
+		value, ok = *node.Value, true
+	}
+
+	for part, i := trie.segmenter(key, 0); part != ""; part, i = trie.segmenter(key, i) {
+		child := node.Children[part]
+		if child == nil {
+			break
+		}
+		node = child
+
+		if node.Value != nil {
+			value, ok = *node.Value, true
+			if i == -1 {
+				matchedKey = key
+			} else {
+				matchedKey = key[0:i]
+			}
+		}
+
+		if i == -1 {
+			break
+		}
+	}
+	return matchedKey, value, ok
+}
+
+// WalkPrefix iterates over each key/Value stored in the subtree rooted at
+// prefix, calling the given walker function for each key/Value. If no node
+// exists at prefix, WalkPrefix is a no-op. If the walker function returns
+// an error, the walk is aborted.
+func (trie *PathTrie[T]) WalkPrefix(prefix string, walker WalkFunc[T]) error {
+	node := trie
+	for part, i := trie.segmenter(prefix, 0); part != ""; part, i = trie.segmenter(prefix, i) {
+		child := node.Children[part]
+		if child == nil {
+			return nil
+		}
+		node = child
+		if i == -1 {
+			break
+		}
+	}
+	return node.walk(prefix, walker)
+}
+
+// DeletePrefix removes every Value stored in the subtree rooted at prefix
+// and returns the number of Values removed, doing the whole subtree delete
+// in one O(subtree) pass instead of walking and deleting keys one by one.
+func (trie *PathTrie[T]) DeletePrefix(prefix string) int {
+	var parent *PathTrie[T]
+	var parentPart string
+	node := trie
+
+	for part, i := trie.segmenter(prefix, 0); part != ""; part, i = trie.segmenter(prefix, i) {
+		child := node.Children[part]
+		if child == nil {
+			return 0
+		}
+		parent, parentPart = node, part
+		node = child
+		if i == -1 {
+			break
+		}
+	}
+
+	removed := node.countValues()
+	if removed == 0 {
+		return 0
+	}
+	if parent != nil {
+		delete(parent.Children, parentPart)
+	} else {
+		node.Value = nil
+		node.Children = nil
+		node.paramChild, node.paramName = nil, ""
+		node.catchAllChild, node.catchAllName = nil, ""
+	}
+	return removed
+}
+
+// countValues returns the number of non-nil Values stored in trie's subtree,
+// including trie itself.
+func (trie *PathTrie[T]) countValues() int {
+	n := 0
+	if trie.Value != nil {
+		n++
+	}
+	for _, child := range trie.Children {
+		n += child.countValues()
+	}
+	if trie.paramChild != nil {
+		n += trie.paramChild.countValues()
+	}
+	if trie.catchAllChild != nil {
+		n += trie.catchAllChild.countValues()
+	}
+	return n
+}
@@ -0,0 +1,106 @@
+package trie
+
+import "testing"
+
+func TestLongestPrefixNoMatch(t *testing.T) {
+	trie := NewPathTrie[int]()
+	trie.Put("/a/b", 1)
+
+	if _, _, ok := trie.LongestPrefix("/x/y"); ok {
+		t.Fatalf("LongestPrefix(/x/y) matched, want no match")
+	}
+}
+
+func TestLongestPrefixRootMatch(t *testing.T) {
+	trie := NewPathTrie[int]()
+	trie.Put("", 1)
+	trie.Put("/a/b", 2)
+
+	matchedKey, value, ok := trie.LongestPrefix("/x/y")
+	if !ok || value != 1 || matchedKey != "" {
+		t.Fatalf("LongestPrefix(/x/y) = %q, %d, %v, want \"\", 1, true", matchedKey, value, ok)
+	}
+}
+
+func TestLongestPrefixDeepestMatch(t *testing.T) {
+	trie := NewPathTrie[int]()
+	trie.Put("/a", 1)
+	trie.Put("/a/b", 2)
+
+	matchedKey, value, ok := trie.LongestPrefix("/a/b/c")
+	if !ok || value != 2 || matchedKey != "/a/b" {
+		t.Fatalf("LongestPrefix(/a/b/c) = %q, %d, %v, want \"/a/b\", 2, true", matchedKey, value, ok)
+	}
+}
+
+func TestWalkPrefixMissingPrefixIsNoOp(t *testing.T) {
+	trie := NewPathTrie[int]()
+	trie.Put("/a/b", 1)
+
+	called := false
+	err := trie.WalkPrefix("/x", func(key string, value int) error {
+		called = true
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("WalkPrefix: %v", err)
+	}
+	if called {
+		t.Fatalf("WalkPrefix(/x) called the walker for a prefix with no matching node")
+	}
+}
+
+func TestWalkPrefixPartialSegmentIsNoOp(t *testing.T) {
+	trie := NewPathTrie[int]()
+	trie.Put("/abc", 1)
+
+	called := false
+	err := trie.WalkPrefix("/ab", func(key string, value int) error {
+		called = true
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("WalkPrefix: %v", err)
+	}
+	if called {
+		t.Fatalf("WalkPrefix(/ab) matched /abc on a partial segment, want no-op")
+	}
+}
+
+func TestDeletePrefixRemovesSubtreeAndCounts(t *testing.T) {
+	trie := NewPathTrie[int]()
+	trie.Put("/a", 1)
+	trie.Put("/a/b", 2)
+	trie.Put("/a/c", 3)
+	trie.Put("/other", 4)
+
+	if removed := trie.DeletePrefix("/a"); removed != 3 {
+		t.Fatalf("DeletePrefix(/a) removed %d, want 3", removed)
+	}
+	if got := trie.Get("/other"); got != 4 {
+		t.Fatalf("DeletePrefix(/a) removed an unrelated sibling, Get(/other) = %d, want 4", got)
+	}
+	if _, ok := trie.Children["/a"]; ok {
+		t.Fatalf("DeletePrefix(/a) left the /a node in place")
+	}
+	if removed := trie.DeletePrefix("/a"); removed != 0 {
+		t.Fatalf("DeletePrefix(/a) a second time removed %d, want 0", removed)
+	}
+}
+
+func TestDeletePrefixEmptyWipesWholeTrie(t *testing.T) {
+	trie := NewPathTrie[int]()
+	trie.Put("/a", 1)
+	trie.Put("/a/b", 2)
+	trie.Put("/other", 3)
+
+	if removed := trie.DeletePrefix(""); removed != 3 {
+		t.Fatalf("DeletePrefix(\"\") removed %d, want 3", removed)
+	}
+	if len(trie.Children) != 0 {
+		t.Fatalf("DeletePrefix(\"\") left children behind: %v", trie.Children)
+	}
+	if trie.Value != nil {
+		t.Fatalf("DeletePrefix(\"\") left a root Value behind")
+	}
+}
@@ -0,0 +1,299 @@
+package trie
+
+import "strings"
+
+// RadixPathTrie is a radix (patricia) compressed variant of PathTrie. Instead
+// of one node per segment, a node's Segments holds a run of segments that
+// have no branching, so chains of single-child nodes collapse into a single
+// edge. Compression is maintained as an invariant by Put and Delete: nodes
+// are split on common segment prefixes on insert and re-merged on removal,
+// so callers never need to run a lossy post-hoc Merge() to get a compact
+// tree. Get, Walk, and WalkPath have the same semantics as PathTrie.
+type RadixPathTrie[T any] struct {
+	segmenter StringSegmenter // key segmenter, must not cause heap allocs
+
+	// Segments is the compressed run of key segments represented by the
+	// edge leading to this node. Downstream visualizers can read it
+	// directly to render compressed subtrees without walking the trie
+	// themselves.
+	Segments []string
+	Value    *T
+	Children map[string]*RadixPathTrie[T]
+}
+
+var _ Trier[any] = (*RadixPathTrie[any])(nil)
+
+// NewRadixPathTrie allocates and returns a new *RadixPathTrie.
+func NewRadixPathTrie[T any]() *RadixPathTrie[T] {
+	return &RadixPathTrie[T]{
+		segmenter: PathSegmenter,
+	}
+}
+
+// NewRadixPathTrieWithConfig allocates and returns a new *RadixPathTrie with
+// the given *PathTrieConfig.
+func NewRadixPathTrieWithConfig[T any](config *PathTrieConfig) *RadixPathTrie[T] {
+	segmenter := PathSegmenter
+	if config != nil && config.Segmenter != nil {
+		segmenter = config.Segmenter
+	}
+
+	return &RadixPathTrie[T]{
+		segmenter: segmenter,
+	}
+}
+
+func (trie *RadixPathTrie[T]) newRadixPathTrie() *RadixPathTrie[T] {
+	return &RadixPathTrie[T]{
+		segmenter: trie.segmenter,
+	}
+}
+
+func (trie *RadixPathTrie[T]) isEmpty() bool {
+	return trie.Segments == nil && trie.Value == nil && len(trie.Children) == 0
+}
+
+// segmentAll splits key into its full sequence of segments using segmenter.
+func segmentAll(segmenter StringSegmenter, key string) []string {
+	var parts []string
+	for part, i := segmenter(key, 0); part != ""; part, i = segmenter(key, i) {
+		parts = append(parts, part)
+		if i == -1 {
+			break
+		}
+	}
+	return parts
+}
+
+// commonPrefixLen returns the number of leading elements a and b have in
+// common.
+func commonPrefixLen(a, b []string) int {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	for i := 0; i < n; i++ {
+		if a[i] != b[i] {
+			return i
+		}
+	}
+	return n
+}
+
+// Get returns the Value stored at the given key. Returns the zero Value for
+// internal nodes or for nodes with a Value of nil.
+func (trie *RadixPathTrie[T]) Get(key string) T {
+	parts := segmentAll(trie.segmenter, key)
+	node := trie
+	i := 0
+	for {
+		common := commonPrefixLen(node.Segments, parts[i:])
+		if common != len(node.Segments) {
+			return *new(T)
+		}
+		i += common
+		if i == len(parts) {
+			if node.Value == nil {
+				return *new(T)
+			}
+			return *node.Value
+		}
+		child := node.Children[parts[i]]
+		if child == nil {
+			return *new(T)
+		}
+		node = child
+	}
+}
+
+// Put inserts the Value into the trie at the given key, replacing any
+// existing Value, and splits nodes as needed to keep the tree compressed.
+// It returns true if the put adds a new Value, false if it replaces an
+// existing one.
+func (trie *RadixPathTrie[T]) Put(key string, value T) bool {
+	parts := segmentAll(trie.segmenter, key)
+	if trie.isEmpty() {
+		trie.Segments = parts
+		trie.Value = &value
+		return true
+	}
+	return trie.putRadix(parts, value)
+}
+
+func (trie *RadixPathTrie[T]) putRadix(parts []string, value T) bool {
+	common := commonPrefixLen(trie.Segments, parts)
+	if common < len(trie.Segments) {
+		tail := trie.newRadixPathTrie()
+		tail.Segments = append([]string(nil), trie.Segments[common:]...)
+		tail.Value = trie.Value
+		tail.Children = trie.Children
+		trie.Segments = append([]string(nil), trie.Segments[:common]...)
+		trie.Value = nil
+		trie.Children = map[string]*RadixPathTrie[T]{tail.Segments[0]: tail}
+	}
+	if common == len(parts) {
+		isNew := trie.Value == nil
+		trie.Value = &value
+		return isNew
+	}
+
+	remaining := parts[common:]
+	if trie.Children == nil {
+		trie.Children = map[string]*RadixPathTrie[T]{}
+	}
+	child := trie.Children[remaining[0]]
+	if child == nil {
+		child = trie.newRadixPathTrie()
+		child.Segments = remaining
+		child.Value = &value
+		trie.Children[remaining[0]] = child
+		return true
+	}
+	return child.putRadix(remaining, value)
+}
+
+// Delete removes the Value associated with the given key, re-merging any
+// nodes left with no Value and a single child. It returns true if a Value
+// was removed.
+func (trie *RadixPathTrie[T]) Delete(key string) bool {
+	parts := segmentAll(trie.segmenter, key)
+	if trie.isEmpty() {
+		return false
+	}
+	common := commonPrefixLen(trie.Segments, parts)
+	if common != len(trie.Segments) {
+		return false
+	}
+
+	var deleted bool
+	if common == len(parts) {
+		if trie.Value == nil {
+			return false
+		}
+		trie.Value = nil
+		deleted = true
+	} else {
+		deleted = trie.deleteChild(parts[common:])
+	}
+
+	if deleted && trie.compress() {
+		trie.Segments = nil
+		trie.Children = nil
+	}
+	return deleted
+}
+
+// deleteChild removes the Value at remaining from the subtree rooted at the
+// child keyed by remaining[0], re-merging that child if it becomes
+// compressible.
+func (trie *RadixPathTrie[T]) deleteChild(remaining []string) bool {
+	child := trie.Children[remaining[0]]
+	if child == nil {
+		return false
+	}
+	common := commonPrefixLen(child.Segments, remaining)
+	if common != len(child.Segments) {
+		return false
+	}
+
+	var deleted bool
+	if common == len(remaining) {
+		if child.Value == nil {
+			return false
+		}
+		child.Value = nil
+		deleted = true
+	} else {
+		deleted = child.deleteChild(remaining[common:])
+	}
+	if !deleted {
+		return false
+	}
+
+	if child.compress() {
+		delete(trie.Children, remaining[0])
+	}
+	return true
+}
+
+// compress collapses trie into its single remaining child, if it has no
+// Value and exactly one child, and reports whether trie now holds nothing
+// and should be dropped by its parent.
+func (trie *RadixPathTrie[T]) compress() bool {
+	if trie.Value != nil {
+		return false
+	}
+	switch len(trie.Children) {
+	case 0:
+		return true
+	case 1:
+		for _, child := range trie.Children {
+			merged := make([]string, 0, len(trie.Segments)+len(child.Segments))
+			merged = append(merged, trie.Segments...)
+			merged = append(merged, child.Segments...)
+			trie.Segments = merged
+			trie.Value = child.Value
+			trie.Children = child.Children
+		}
+		return false
+	default:
+		return false
+	}
+}
+
+// Walk iterates over each key/Value stored in the trie and calls the given
+// walker function with the key and Value. If the walker function returns
+// an error, the walk is aborted.
+// The traversal is depth first with no guaranteed order.
+func (trie *RadixPathTrie[T]) Walk(walker WalkFunc[T]) error {
+	return trie.walk("", walker)
+}
+
+func (trie *RadixPathTrie[T]) walk(prefix string, walker WalkFunc[T]) error {
+	key := prefix + strings.Join(trie.Segments, "")
+	if trie.Value != nil {
+		if err := walker(key, *trie.Value); err != nil {
+			if err == SkipSubtree {
+				return nil
+			}
+			return err
+		}
+	}
+	for _, child := range trie.Children {
+		if err := child.walk(key, walker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WalkPath iterates over each key/Value in the path in trie from the root to
+// the node at the given key, calling the given walker function for each
+// key/Value. If the walker function returns an error, the walk is aborted.
+func (trie *RadixPathTrie[T]) WalkPath(key string, walker WalkFunc[T]) error {
+	parts := segmentAll(trie.segmenter, key)
+	node := trie
+	accumulated := ""
+	i := 0
+	for {
+		common := commonPrefixLen(node.Segments, parts[i:])
+		if common != len(node.Segments) {
+			return nil
+		}
+		i += common
+		accumulated += strings.Join(node.Segments, "")
+		if node.Value != nil {
+			if err := walker(accumulated, *node.Value); err != nil {
+				return err
+			}
+		}
+		if i == len(parts) {
+			return nil
+		}
+		child := node.Children[parts[i]]
+		if child == nil {
+			return nil
+		}
+		node = child
+	}
+}
@@ -0,0 +1,115 @@
+package trie
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+var _ Trier[int] = (*RadixPathTrie[int])(nil)
+
+func TestRadixPathTriePutReturnsWhetherValueIsNew(t *testing.T) {
+	trie := NewRadixPathTrie[int]()
+	if isNew := trie.Put("/a/b", 1); !isNew {
+		t.Fatalf("Put(/a/b) on an empty trie returned isNew=false")
+	}
+	if isNew := trie.Put("/a/b", 2); isNew {
+		t.Fatalf("Put(/a/b) over an existing Value returned isNew=true")
+	}
+	// Put that forces a split (common prefix shorter than Segments) still
+	// adds a brand new Value, in a new child below the split point.
+	if isNew := trie.Put("/a/c", 3); !isNew {
+		t.Fatalf("Put(/a/c) forcing a split returned isNew=false")
+	}
+}
+
+func TestRadixPathTrieSplitAndMergeConverge(t *testing.T) {
+	trie := NewRadixPathTrie[int]()
+	keys := []string{"/a", "/a/b", "/a/b/c", "/a/b/d", "/a/e", "/f"}
+	for i, k := range keys {
+		trie.Put(k, i+1)
+	}
+
+	for i, k := range keys {
+		if got := trie.Get(k); got != i+1 {
+			t.Fatalf("Get(%s) = %d, want %d", k, got, i+1)
+		}
+	}
+
+	for _, k := range keys {
+		if !trie.Delete(k) {
+			t.Fatalf("Delete(%s) returned false", k)
+		}
+	}
+
+	if !trie.isEmpty() {
+		t.Fatalf("trie not empty after deleting every key it holds: Segments=%v Value=%v Children=%v", trie.Segments, trie.Value, trie.Children)
+	}
+}
+
+func TestRadixPathTrieWalkAndWalkPath(t *testing.T) {
+	trie := NewRadixPathTrie[int]()
+	trie.Put("/a", 1)
+	trie.Put("/a/b", 2)
+	trie.Put("/a/c", 3)
+
+	var walked []string
+	err := trie.Walk(func(key string, value int) error {
+		walked = append(walked, key)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Walk: %v", err)
+	}
+	sort.Strings(walked)
+	want := []string{"/a", "/a/b", "/a/c"}
+	if !reflect.DeepEqual(walked, want) {
+		t.Fatalf("Walk visited %v, want %v", walked, want)
+	}
+
+	var walkedPath []string
+	err = trie.WalkPath("/a/b", func(key string, value int) error {
+		walkedPath = append(walkedPath, key)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("WalkPath: %v", err)
+	}
+	wantPath := []string{"/a", "/a/b"}
+	if !reflect.DeepEqual(walkedPath, wantPath) {
+		t.Fatalf("WalkPath(/a/b) visited %v, want %v", walkedPath, wantPath)
+	}
+}
+
+func TestRadixPathTrieGetOnPartialSegmentRun(t *testing.T) {
+	trie := NewRadixPathTrie[int]()
+	trie.Put("/a/b/c", 1)
+
+	if got := trie.Get("/a/b"); got != 0 {
+		t.Fatalf("Get(/a/b) = %d, want 0; /a/b is only a partial match of the compressed /a/b/c run", got)
+	}
+	if got := trie.Get("/a/b/c/d"); got != 0 {
+		t.Fatalf("Get(/a/b/c/d) = %d, want 0; key extends past the only node that has a Value", got)
+	}
+	if got := trie.Get("/x"); got != 0 {
+		t.Fatalf("Get(/x) = %d, want 0; no common prefix with any Segments at all", got)
+	}
+}
+
+func TestRadixPathTrieSegmentsNotAliasedAcrossDelete(t *testing.T) {
+	trie := NewRadixPathTrie[int]()
+	trie.Put("/a/b/c/d", 1)
+	trie.Put("/a/b/e", 2)
+
+	child := trie.Children["/c"]
+	captured := child.Segments // no copy: this is what an external visualizer would hold
+
+	trie.Delete("/a/b/c/d")
+
+	if !reflect.DeepEqual(captured, []string{"/c", "/d"}) {
+		t.Fatalf("previously captured Segments mutated by an unrelated Delete, got %v", captured)
+	}
+	if got := trie.Get("/a/b/e"); got != 2 {
+		t.Fatalf("Get(/a/b/e) = %d, want 2", got)
+	}
+}
@@ -0,0 +1,134 @@
+package trie
+
+// PathParam is a single named segment captured while matching a :param or
+// *catchAll segment registered via Put on a routing-enabled PathTrie.
+type PathParam struct {
+	Key   string
+	Value string
+}
+
+// PathParams is the ordered set of parameters captured by Match. Order
+// matches the order the wildcards appear in the registered route.
+type PathParams []PathParam
+
+// Get returns the value of the first parameter with the given name.
+func (params PathParams) Get(name string) (string, bool) {
+	for _, p := range params {
+		if p.Key == name {
+			return p.Value, true
+		}
+	}
+	return "", false
+}
+
+// wildcardKind reports whether part (as produced by the trie's segmenter,
+// including its leading delimiter) names a :param or *catchAll segment, and
+// returns the wildcard's name. It only inspects the byte right after the
+// leading delimiter, so a static segment that merely contains a ':' or '*'
+// elsewhere (a timestamp, a port, ...) is never mistaken for a wildcard. It
+// returns kind 0 for a plain static segment.
+func wildcardKind(part string) (kind byte, name string) {
+	if len(part) < 2 {
+		return 0, ""
+	}
+	switch part[1] {
+	case ':', '*':
+		return part[1], part[2:]
+	}
+	return 0, ""
+}
+
+// trimDelim strips the single leading delimiter byte a segmenter includes
+// in each part (e.g. the "/" in "/a"), returning the raw captured text.
+func trimDelim(part string) string {
+	if len(part) > 0 {
+		return part[1:]
+	}
+	return part
+}
+
+// putWildcard registers node's :param or *catchAll child for the given
+// wildcard kind and name, enforcing that a node has at most one :param name,
+// at most one *catchAll name, and never both a :param and a *catchAll child
+// at once (regardless of which is registered first), and that *catchAll is
+// only ever the last segment of a route. It panics on a conflicting
+// registration rather than silently accepting an ambiguous route.
+func (node *PathTrie[T]) putWildcard(kind byte, name string, nextIndex int) *PathTrie[T] {
+	switch kind {
+	case ':':
+		if node.catchAllChild != nil {
+			panic("trie: cannot register :" + name + " after *" + node.catchAllName)
+		}
+		if node.paramChild != nil && node.paramName != name {
+			panic("trie: conflicting :param names :" + node.paramName + " and :" + name)
+		}
+		if node.paramChild == nil {
+			node.paramChild = node.newPathTrie()
+			node.paramName = name
+		}
+		return node.paramChild
+	case '*':
+		if node.paramChild != nil {
+			panic("trie: cannot register *" + name + " after :" + node.paramName)
+		}
+		if nextIndex != -1 {
+			panic("trie: *" + name + " must be the last segment of a route")
+		}
+		if node.catchAllChild != nil && node.catchAllName != name {
+			panic("trie: conflicting *catchAll names *" + node.catchAllName + " and *" + name)
+		}
+		if node.catchAllChild == nil {
+			node.catchAllChild = node.newPathTrie()
+			node.catchAllName = name
+		}
+		return node.catchAllChild
+	default:
+		panic("trie: unreachable wildcard kind")
+	}
+}
+
+// Match looks up key the same way Get does, but additionally matches
+// :param and *catchAll segments registered via Put on a routing-enabled
+// PathTrie, returning the captured PathParams alongside the Value. Static
+// children are tried before :param, which is tried before *catchAll; if a
+// branch doesn't lead to a registered Value, Match backtracks and tries the
+// next one, so a specific static route and a catch-all dynamic route can
+// coexist under the same prefix.
+func (trie *PathTrie[T]) Match(key string) (T, PathParams, bool) {
+	node, params, ok := matchSegment(trie, key, 0)
+	if !ok {
+		return *new(T), nil, false
+	}
+	return *node.Value, params, true
+}
+
+func matchSegment[T any](node *PathTrie[T], key string, start int) (*PathTrie[T], PathParams, bool) {
+	part, next := node.segmenter(key, start)
+	if part == "" {
+		if node.Value == nil {
+			return nil, nil, false
+		}
+		return node, nil, true
+	}
+
+	if child := node.Children[part]; child != nil {
+		if matched, params, ok := matchSegment(child, key, next); ok {
+			return matched, params, true
+		}
+	}
+	if node.paramChild != nil {
+		if matched, params, ok := matchSegment(node.paramChild, key, next); ok {
+			param := PathParam{Key: node.paramName, Value: trimDelim(part)}
+			return matched, append(PathParams{param}, params...), true
+		}
+	}
+	if node.catchAllChild != nil && node.catchAllChild.Value != nil {
+		rest := part
+		if next != -1 {
+			rest = part + key[next:]
+		}
+		param := PathParam{Key: node.catchAllName, Value: trimDelim(rest)}
+		return node.catchAllChild, PathParams{param}, true
+	}
+	return nil, nil, false
+}
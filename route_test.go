@@ -0,0 +1,70 @@
+package trie
+
+import "testing"
+
+func TestWildcardKindIgnoresLiteralColonInStaticSegment(t *testing.T) {
+	trie := NewPathTrieWithConfig[int](&PathTrieConfig{Routing: true})
+	trie.Put("/time/12:30", 111)
+
+	if _, _, ok := trie.Match("/time/99:00"); ok {
+		t.Fatalf("Match(/time/99:00) matched a static segment containing ':' as a wildcard")
+	}
+	if got, _, ok := trie.Match("/time/12:30"); !ok || got != 111 {
+		t.Fatalf("Match(/time/12:30) = %d, %v, want 111, true", got, ok)
+	}
+}
+
+func TestMatchBacktracksToSiblingWildcard(t *testing.T) {
+	trie := NewPathTrieWithConfig[int](&PathTrieConfig{Routing: true})
+	trie.Put("/a/static/x", 1)
+	trie.Put("/a/:id", 2)
+
+	got, params, ok := trie.Match("/a/static")
+	if !ok || got != 2 {
+		t.Fatalf("Match(/a/static) = %d, %v, want 2, true", got, ok)
+	}
+	if v, ok := params.Get("id"); !ok || v != "static" {
+		t.Fatalf("params[id] = %q, %v, want \"static\", true", v, ok)
+	}
+
+	if got, _, ok := trie.Match("/a/static/x"); !ok || got != 1 {
+		t.Fatalf("Match(/a/static/x) = %d, %v, want 1, true", got, ok)
+	}
+}
+
+func TestPutWildcardRejectsParamAndCatchAllOnSameNodeRegardlessOfOrder(t *testing.T) {
+	paramThenCatchAll := func() (panicked bool) {
+		defer func() { panicked = recover() != nil }()
+		trie := NewPathTrieWithConfig[int](&PathTrieConfig{Routing: true})
+		trie.Put("/a/:id", 1)
+		trie.Put("/a/*rest", 2)
+		return false
+	}
+	catchAllThenParam := func() (panicked bool) {
+		defer func() { panicked = recover() != nil }()
+		trie := NewPathTrieWithConfig[int](&PathTrieConfig{Routing: true})
+		trie.Put("/a/*rest", 2)
+		trie.Put("/a/:id", 1)
+		return false
+	}
+
+	if paramThenCatchAll() != catchAllThenParam() {
+		t.Fatalf(":param then *catchAll panicked=%v, but *catchAll then :param panicked=%v; should agree", paramThenCatchAll(), catchAllThenParam())
+	}
+	if !paramThenCatchAll() {
+		t.Fatalf("registering :param and *catchAll on the same node did not panic in either order")
+	}
+}
+
+func TestMatchCatchAll(t *testing.T) {
+	trie := NewPathTrieWithConfig[int](&PathTrieConfig{Routing: true})
+	trie.Put("/files/*path", 1)
+
+	got, params, ok := trie.Match("/files/a/b/c")
+	if !ok || got != 1 {
+		t.Fatalf("Match(/files/a/b/c) = %d, %v, want 1, true", got, ok)
+	}
+	if v, ok := params.Get("path"); !ok || v != "a/b/c" {
+		t.Fatalf("params[path] = %q, %v, want \"a/b/c\", true", v, ok)
+	}
+}
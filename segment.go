@@ -0,0 +1,29 @@
+package trie
+
+import "strings"
+
+// WalkFunc is the type of the function called for each key/Value pair
+// visited by Walk, WalkPath, and the other traversal methods. The key
+// argument contains the key that triggered the call.
+type WalkFunc[T any] func(key string, value T) error
+
+// StringSegmenter segments a string key into segments, starting the search
+// for the next segment at the given index. It returns the segment and the
+// index to resume from on the next call, or -1 if there is nothing left to
+// segment. It must not cause heap allocations.
+type StringSegmenter func(path string, start int) (segment string, next int)
+
+const pathDelimiter = '/'
+
+// PathSegmenter segments string keys by forward slash, including the
+// leading slash in each segment, e.g. "/a/b/c" -> "/a", "/b", "/c".
+func PathSegmenter(path string, start int) (segment string, next int) {
+	if len(path) == 0 || start < 0 || start > len(path)-1 {
+		return "", -1
+	}
+	end := strings.IndexRune(path[start+1:], pathDelimiter)
+	if end == -1 {
+		return path[start:], -1
+	}
+	return path[start : start+end+1], start + end + 1
+}
@@ -0,0 +1,329 @@
+package trie
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+)
+
+// ValueCodec encodes and decodes a node's Value for Marshal and Unmarshal.
+// A codec is required because T is generic: PathTrie itself has no way to
+// gob- or json-encode an arbitrary T without the caller's help.
+type ValueCodec[T any] struct {
+	Encode func(T) ([]byte, error)
+	Decode func([]byte) (T, error)
+}
+
+// Marshal writes trie to w in a compact framed binary format: for each node,
+// a has-Value flag, the codec-encoded Value (if any), a child count, then
+// for each child its segment length, segment bytes, and the child itself
+// written recursively, and finally its :param and *catchAll wildcard
+// children (each a has-child flag, the wildcard's name, and the child
+// written recursively), so a routing-enabled trie round-trips its routes
+// rather than silently losing them. Marshal streams directly to w and never
+// holds more than one node's encoded Value in memory at a time.
+func (trie *PathTrie[T]) Marshal(w io.Writer, codec ValueCodec[T]) error {
+	if err := writeValue(w, trie.Value, codec); err != nil {
+		return err
+	}
+	if err := writeUvarint(w, uint64(len(trie.Children))); err != nil {
+		return err
+	}
+	for part, child := range trie.Children {
+		if err := writeBytes(w, []byte(part)); err != nil {
+			return err
+		}
+		if err := child.Marshal(w, codec); err != nil {
+			return err
+		}
+	}
+	if err := writeWildcardChild(w, trie.paramChild, trie.paramName, codec); err != nil {
+		return err
+	}
+	return writeWildcardChild(w, trie.catchAllChild, trie.catchAllName, codec)
+}
+
+func writeWildcardChild[T any](w io.Writer, child *PathTrie[T], name string, codec ValueCodec[T]) error {
+	if child == nil {
+		_, err := w.Write([]byte{0})
+		return err
+	}
+	if _, err := w.Write([]byte{1}); err != nil {
+		return err
+	}
+	if err := writeBytes(w, []byte(name)); err != nil {
+		return err
+	}
+	return child.Marshal(w, codec)
+}
+
+// Unmarshal reads a tree written by Marshal from r into trie, replacing any
+// existing Value, Children, and wildcard children.
+func (trie *PathTrie[T]) Unmarshal(r io.Reader, codec ValueCodec[T]) error {
+	br, ok := r.(*bufio.Reader)
+	if !ok {
+		br = bufio.NewReader(r)
+	}
+	return trie.unmarshal(br, codec)
+}
+
+func (trie *PathTrie[T]) unmarshal(r *bufio.Reader, codec ValueCodec[T]) error {
+	value, err := readValue(r, codec)
+	if err != nil {
+		return err
+	}
+	trie.Value = value
+
+	childCount, err := binary.ReadUvarint(r)
+	if err != nil {
+		return err
+	}
+	trie.Children = nil
+	if childCount > 0 {
+		trie.Children = make(map[string]*PathTrie[T], childCount)
+		for i := uint64(0); i < childCount; i++ {
+			segment, err := readBytes(r)
+			if err != nil {
+				return err
+			}
+			child := trie.newPathTrie()
+			if err := child.unmarshal(r, codec); err != nil {
+				return err
+			}
+			trie.Children[string(segment)] = child
+		}
+	}
+
+	trie.paramChild, trie.paramName, err = readWildcardChild(r, trie, codec)
+	if err != nil {
+		return err
+	}
+	trie.catchAllChild, trie.catchAllName, err = readWildcardChild(r, trie, codec)
+	return err
+}
+
+func readWildcardChild[T any](r *bufio.Reader, parent *PathTrie[T], codec ValueCodec[T]) (*PathTrie[T], string, error) {
+	flag, err := r.ReadByte()
+	if err != nil {
+		return nil, "", err
+	}
+	if flag == 0 {
+		return nil, "", nil
+	}
+	name, err := readBytes(r)
+	if err != nil {
+		return nil, "", err
+	}
+	child := parent.newPathTrie()
+	if err := child.unmarshal(r, codec); err != nil {
+		return nil, "", err
+	}
+	return child, string(name), nil
+}
+
+// Marshal writes trie to w in the same framed binary format as
+// PathTrie.Marshal, except each node writes its full compressed Segments
+// run instead of a single map key, preserving the radix split points.
+func (trie *RadixPathTrie[T]) Marshal(w io.Writer, codec ValueCodec[T]) error {
+	if err := writeValue(w, trie.Value, codec); err != nil {
+		return err
+	}
+	if err := writeUvarint(w, uint64(len(trie.Children))); err != nil {
+		return err
+	}
+	for _, child := range trie.Children {
+		if err := writeUvarint(w, uint64(len(child.Segments))); err != nil {
+			return err
+		}
+		for _, seg := range child.Segments {
+			if err := writeBytes(w, []byte(seg)); err != nil {
+				return err
+			}
+		}
+		if err := child.Marshal(w, codec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Unmarshal reads a tree written by RadixPathTrie.Marshal from r into trie.
+func (trie *RadixPathTrie[T]) Unmarshal(r io.Reader, codec ValueCodec[T]) error {
+	br, ok := r.(*bufio.Reader)
+	if !ok {
+		br = bufio.NewReader(r)
+	}
+	return trie.unmarshal(br, codec)
+}
+
+func (trie *RadixPathTrie[T]) unmarshal(r *bufio.Reader, codec ValueCodec[T]) error {
+	value, err := readValue(r, codec)
+	if err != nil {
+		return err
+	}
+	trie.Value = value
+
+	childCount, err := binary.ReadUvarint(r)
+	if err != nil {
+		return err
+	}
+	if childCount == 0 {
+		trie.Children = nil
+		return nil
+	}
+
+	trie.Children = make(map[string]*RadixPathTrie[T], childCount)
+	for i := uint64(0); i < childCount; i++ {
+		segCount, err := binary.ReadUvarint(r)
+		if err != nil {
+			return err
+		}
+		segments := make([]string, segCount)
+		for s := range segments {
+			seg, err := readBytes(r)
+			if err != nil {
+				return err
+			}
+			segments[s] = string(seg)
+		}
+		child := trie.newRadixPathTrie()
+		child.Segments = segments
+		if err := child.unmarshal(r, codec); err != nil {
+			return err
+		}
+		trie.Children[segments[0]] = child
+	}
+	return nil
+}
+
+func writeValue[T any](w io.Writer, value *T, codec ValueCodec[T]) error {
+	if value == nil {
+		_, err := w.Write([]byte{0})
+		return err
+	}
+	if _, err := w.Write([]byte{1}); err != nil {
+		return err
+	}
+	encoded, err := codec.Encode(*value)
+	if err != nil {
+		return err
+	}
+	return writeBytes(w, encoded)
+}
+
+func readValue[T any](r *bufio.Reader, codec ValueCodec[T]) (*T, error) {
+	flag, err := r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	if flag == 0 {
+		return nil, nil
+	}
+	encoded, err := readBytes(r)
+	if err != nil {
+		return nil, err
+	}
+	value, err := codec.Decode(encoded)
+	if err != nil {
+		return nil, err
+	}
+	return &value, nil
+}
+
+func writeUvarint(w io.Writer, v uint64) error {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], v)
+	_, err := w.Write(buf[:n])
+	return err
+}
+
+func writeBytes(w io.Writer, b []byte) error {
+	if err := writeUvarint(w, uint64(len(b))); err != nil {
+		return err
+	}
+	_, err := w.Write(b)
+	return err
+}
+
+func readBytes(r *bufio.Reader) ([]byte, error) {
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, n)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// jsonNode is the JSON-friendly shape of a PathTrie node: a Value (omitted
+// for internal nodes), a map of segment to child, and its :param/*catchAll
+// wildcard children, encoded/decoded with encoding/json directly since,
+// unlike Marshal, JSON already knows how to (un)marshal an arbitrary generic
+// T via reflection.
+type jsonNode[T any] struct {
+	Value         *T                      `json:"value,omitempty"`
+	Children      map[string]*PathTrie[T] `json:"children,omitempty"`
+	ParamName     string                  `json:"paramName,omitempty"`
+	ParamChild    *PathTrie[T]            `json:"paramChild,omitempty"`
+	CatchAllName  string                  `json:"catchAllName,omitempty"`
+	CatchAllChild *PathTrie[T]            `json:"catchAllChild,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (trie *PathTrie[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonNode[T]{
+		Value:         trie.Value,
+		Children:      trie.Children,
+		ParamName:     trie.paramName,
+		ParamChild:    trie.paramChild,
+		CatchAllName:  trie.catchAllName,
+		CatchAllChild: trie.catchAllChild,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler. encoding/json allocates each
+// child as a bare zero-value *PathTrie before recursing into its own
+// UnmarshalJSON, so none of them come out of this with a segmenter set;
+// propagateConfig fixes that up across the whole subtree once trie's own
+// fields (already correct, from whatever constructed trie before this call)
+// are in place.
+func (trie *PathTrie[T]) UnmarshalJSON(data []byte) error {
+	var node jsonNode[T]
+	if err := json.Unmarshal(data, &node); err != nil {
+		return err
+	}
+	trie.Value = node.Value
+	trie.Children = node.Children
+	trie.paramName = node.ParamName
+	trie.paramChild = node.ParamChild
+	trie.catchAllName = node.CatchAllName
+	trie.catchAllChild = node.CatchAllChild
+	trie.propagateConfig()
+	return nil
+}
+
+// propagateConfig copies trie's segmenter and routing flag onto every node
+// in its subtree. It's a no-op when called on a node whose own config isn't
+// set yet (e.g. partway through a nested UnmarshalJSON call); the outermost
+// UnmarshalJSON call is the one that runs last and leaves the whole subtree
+// correct.
+func (trie *PathTrie[T]) propagateConfig() {
+	for _, child := range trie.Children {
+		child.segmenter = trie.segmenter
+		child.routing = trie.routing
+		child.propagateConfig()
+	}
+	if trie.paramChild != nil {
+		trie.paramChild.segmenter = trie.segmenter
+		trie.paramChild.routing = trie.routing
+		trie.paramChild.propagateConfig()
+	}
+	if trie.catchAllChild != nil {
+		trie.catchAllChild.segmenter = trie.segmenter
+		trie.catchAllChild.routing = trie.routing
+		trie.catchAllChild.propagateConfig()
+	}
+}
@@ -0,0 +1,62 @@
+package trie
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"testing"
+)
+
+var intCodec = ValueCodec[int]{
+	Encode: func(v int) ([]byte, error) { return []byte(strconv.Itoa(v)), nil },
+	Decode: func(b []byte) (int, error) { return strconv.Atoi(string(b)) },
+}
+
+func TestJSONRoundTripRestoresSegmenter(t *testing.T) {
+	trie := NewPathTrie[int]()
+	trie.Put("/a/b/c", 42)
+
+	data, err := json.Marshal(trie)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	restored := NewPathTrie[int]()
+	if err := json.Unmarshal(data, restored); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	var got int
+	if err := restored.WalkPath("/a/b/c", func(key string, value int) error {
+		got = value
+		return nil
+	}); err != nil {
+		t.Fatalf("WalkPath: %v", err)
+	}
+	if got != 42 {
+		t.Fatalf("WalkPath found value %d, want 42", got)
+	}
+}
+
+func TestBinaryRoundTripPreservesWildcardRoutes(t *testing.T) {
+	trie := NewPathTrieWithConfig[int](&PathTrieConfig{Routing: true})
+	trie.Put("/users/:id", 1)
+	trie.Put("/files/*path", 2)
+
+	var buf bytes.Buffer
+	if err := trie.Marshal(&buf, intCodec); err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	restored := NewPathTrieWithConfig[int](&PathTrieConfig{Routing: true})
+	if err := restored.Unmarshal(&buf, intCodec); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	if got, _, ok := restored.Match("/users/42"); !ok || got != 1 {
+		t.Fatalf("Match(/users/42) = %d, %v, want 1, true", got, ok)
+	}
+	if got, _, ok := restored.Match("/files/a/b"); !ok || got != 2 {
+		t.Fatalf("Match(/files/a/b) = %d, %v, want 2, true", got, ok)
+	}
+}
@@ -0,0 +1,92 @@
+package trie
+
+import (
+	"errors"
+	"sort"
+)
+
+// SkipSubtree is returned by a WalkFunc to abandon the subtree rooted at
+// the node just visited while continuing the walk over its siblings,
+// analogous to filepath.SkipDir. Returning any other non-nil error aborts
+// the walk entirely. Walk, WalkSorted and WalkPrefix honor it; WalkPath
+// returns it to the caller like any other error.
+var SkipSubtree = errors.New("trie: skip subtree")
+
+// WalkVisitor receives a pre-order and a post-order callback for each node
+// visited by Accept. Visit is called before a node's children are visited;
+// returning false skips the node's subtree but not its siblings. Leave is
+// called after a node's children have all returned; it is not called for a
+// node whose Visit returned false.
+type WalkVisitor[T any] interface {
+	Visit(key string, node *PathTrie[T]) bool
+	Leave(key string, node *PathTrie[T])
+}
+
+// Accept walks the trie depth-first, calling visitor's Visit before
+// descending into a node's children and Leave after.
+func (trie *PathTrie[T]) Accept(visitor WalkVisitor[T]) {
+	trie.accept("", visitor)
+}
+
+func (trie *PathTrie[T]) accept(key string, visitor WalkVisitor[T]) {
+	if !visitor.Visit(key, trie) {
+		return
+	}
+	for part, child := range trie.Children {
+		child.accept(key+part, visitor)
+	}
+	visitor.Leave(key, trie)
+}
+
+// Inspect traverses the trie in depth-first order, modeled on go/ast's
+// Inspect. It calls f(node) for each node before descending into its
+// children; if f returns false, Inspect skips node's children entirely
+// (it does not call f(nil) for that node, just as ast.Inspect does not).
+// Otherwise, once node's children have been visited, Inspect calls f(nil)
+// as a post-order marker.
+func Inspect[T any](node *PathTrie[T], f func(*PathTrie[T]) bool) {
+	if node == nil {
+		return
+	}
+	if !f(node) {
+		return
+	}
+	for _, child := range node.Children {
+		Inspect(child, f)
+	}
+	f(nil)
+}
+
+// WalkSorted iterates over each key/Value stored in the trie in
+// lexicographic key order and calls the given walker function with the key
+// and Value. Unlike Walk, traversal order is deterministic, which makes it
+// suitable for golden-file tests and reproducible DOT/graph output. If the
+// walker function returns SkipSubtree, the subtree rooted at the node just
+// visited is skipped; any other non-nil error aborts the walk.
+func (trie *PathTrie[T]) WalkSorted(walker WalkFunc[T]) error {
+	return trie.walkSorted("", walker)
+}
+
+func (trie *PathTrie[T]) walkSorted(key string, walker WalkFunc[T]) error {
+	if trie.Value != nil {
+		if err := walker(key, *trie.Value); err != nil {
+			if err == SkipSubtree {
+				return nil
+			}
+			return err
+		}
+	}
+
+	parts := make([]string, 0, len(trie.Children))
+	for part := range trie.Children {
+		parts = append(parts, part)
+	}
+	sort.Strings(parts)
+
+	for _, part := range parts {
+		if err := trie.Children[part].walkSorted(key+part, walker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package trie
+
+import "testing"
+
+func TestInspectSkipsPostOrderMarkerWhenVisitReturnsFalse(t *testing.T) {
+	trie := NewPathTrie[int]()
+	trie.Put("/a", 1)
+	trie.Put("/a/b", 2)
+
+	// root -> "/a" (Visit returns false here) -> "/b". With root's own Visit
+	// returning true, the only calls should be f(root), f(skipped), and
+	// f(nil) for root's post-order marker: never f(grandchild) and never a
+	// second f(nil) for the skipped node.
+	skipped := trie.Children["/a"]
+	var sequence []*PathTrie[int]
+
+	Inspect(trie, func(node *PathTrie[int]) bool {
+		sequence = append(sequence, node)
+		return node != skipped
+	})
+
+	want := []*PathTrie[int]{trie, skipped, nil}
+	if len(sequence) != len(want) {
+		t.Fatalf("Inspect call sequence = %v (len %d), want len %d", sequence, len(sequence), len(want))
+	}
+	for i := range want {
+		if sequence[i] != want[i] {
+			t.Fatalf("Inspect call %d = %p, want %p", i, sequence[i], want[i])
+		}
+	}
+}